@@ -15,6 +15,10 @@ import (
 // Deprecated: use go-libp2p/p2p/net/connmgr.BasicConnMgr instead.
 type BasicConnMgr = lconnmgr.BasicConnMgr
 
+// CMInfo holds the configuration for BasicConnMgr, as well as status data.
+// Deprecated: use go-libp2p/p2p/net/connmgr.CMInfo instead.
+type CMInfo = lconnmgr.CMInfo
+
 // NewConnManager creates a new BasicConnMgr with the provided params:
 // lo and hi are watermarks governing the number of connections that'll be maintained.
 // When the peer count exceeds the 'high watermark', as many peers will be pruned (and